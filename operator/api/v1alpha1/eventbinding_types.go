@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EventBindingSpec maps an incoming CloudEvent to a target Workflow.
+type EventBindingSpec struct {
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// EventType matches the CloudEvent "type" attribute, e.g. "com.example.order.created".
+	EventType string `json:"eventType,omitempty"`
+	// Filter is a CEL expression evaluated against the CloudEvent's type, source and data; the
+	// event is only dispatched when it evaluates to true. An empty Filter always matches.
+	Filter string `json:"filter,omitempty"`
+	// Workflow is the name of the Workflow a matching event instantiates a WorkflowRun for.
+	Workflow string `json:"workflow,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="EventType",type=string,JSONPath=`.spec.eventType`
+// +kubebuilder:printcolumn:name="Workflow",type=string,JSONPath=`.spec.workflow`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// EventBinding routes CloudEvents received by the event ingress to a target Workflow. It has no
+// status subresource: nothing reconciles an EventBinding, it is only ever read by the event
+// ingress receiver.
+type EventBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec EventBindingSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type EventBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventBinding `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&EventBinding{}, &EventBindingList{})
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MemorySpec holds the conversational memory settings of an LLMChain. The step executor does not
+// read them: each invocation of the chain sends only the rendered template, with no history.
+type MemorySpec struct {
+	// Type selects the memory strategy, e.g. "buffer" or "summary".
+	Type string `json:"type,omitempty"`
+	// MaxTokens bounds how much history is retained.
+	MaxTokens int `json:"maxTokens,omitempty"`
+}
+
+// LLMChainSpec references a model and a PromptTemplate node, with optional memory.
+type LLMChainSpec struct {
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Model is the name of the Agent this chain invokes with the rendered Template.
+	Model    string     `json:"model,omitempty"`
+	Template Ref        `json:"template,omitempty"`
+	Memory   MemorySpec `json:"memory,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Model",type=string,JSONPath=`.spec.model`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// LLMChain is a composable node that pairs a model with a PromptTemplate and memory config. It
+// has no status subresource: nothing reconciles an LLMChain, it is only ever read by reference
+// from a Step.
+type LLMChain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec LLMChainSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type LLMChainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LLMChain `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LLMChain{}, &LLMChainList{})
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TypedField describes one named input or output of a PromptTemplate.
+type TypedField struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// PromptTemplateSpec defines a reusable Go text/template prompt body with typed inputs/outputs.
+type PromptTemplateSpec struct {
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Body is a Go text/template source rendered against the inputs at step execution time.
+	Body string `json:"body,omitempty"`
+	// Inputs are the named, typed variables the Body template expects.
+	Inputs []TypedField `json:"inputs,omitempty"`
+	// Outputs describes the named, typed fields produced by rendering the template.
+	Outputs []TypedField `json:"outputs,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// PromptTemplate is a composable node that renders a Go text/template prompt body. It has no
+// status subresource: nothing reconciles a PromptTemplate, it is only ever read by reference
+// from a Step or an LLMChain.
+type PromptTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PromptTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type PromptTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PromptTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PromptTemplate{}, &PromptTemplateList{})
+}
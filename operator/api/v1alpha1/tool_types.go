@@ -0,0 +1,63 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InvocationSpec configures how a Tool is invoked at runtime.
+type InvocationSpec struct {
+	// Url is the endpoint the tool is invoked on.
+	Url string `json:"url,omitempty"`
+	// Timeout bounds how long a single invocation may run, e.g. "30s".
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// ToolSpec describes a reusable, named tool with a JSON schema and invocation config.
+type ToolSpec struct {
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Name       string               `json:"name,omitempty"`
+	Schema     apiextensionsv1.JSON `json:"schema,omitempty"`
+	Invocation InvocationSpec       `json:"invocation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Tool is a composable node describing a named, schema-validated tool invocation. It has no
+// status subresource: nothing reconciles a Tool, it is only ever read by reference from a Step.
+type Tool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ToolSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type ToolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Tool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Tool{}, &ToolList{})
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VectorStoreSpec describes an embedding model paired with a vector backend.
+type VectorStoreSpec struct {
+	// Important: Run "make" to regenerate code after modifying this file
+
+	EmbeddingModel string `json:"embeddingModel,omitempty"`
+	// BackendUri addresses the vector store instance, e.g. "milvus://host:19530/collection".
+	BackendUri string `json:"backendUri,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Backend",type=string,JSONPath=`.spec.backendUri`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VectorStore is a composable node describing an embedding model and its backing vector store.
+// It has no status subresource: nothing reconciles a VectorStore, it is only ever read by
+// reference from a Step.
+type VectorStore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VectorStoreSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type VectorStoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VectorStore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VectorStore{}, &VectorStoreList{})
+}
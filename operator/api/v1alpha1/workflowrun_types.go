@@ -17,9 +17,41 @@ limitations under the License.
 package v1alpha1
 
 import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// Phase is the coarse-grained lifecycle state shared by WorkflowRun, Workflow and per-step status.
+type Phase string
+
+const (
+	PhasePending   Phase = "Pending"
+	PhaseRunning   Phase = "Running"
+	PhaseSucceeded Phase = "Succeeded"
+	PhaseFailed    Phase = "Failed"
+	PhaseCancelled Phase = "Cancelled"
+)
+
+// Condition types set on WorkflowRun/Workflow/Agent status.
+const (
+	ConditionTypeReady       = "Ready"
+	ConditionTypeProgressing = "Progressing"
+	ConditionTypeDegraded    = "Degraded"
+)
+
+// StepStatus records the observed state of a single Step within a WorkflowRun.
+type StepStatus struct {
+	Name      string       `json:"name,omitempty"`
+	Phase     Phase        `json:"phase,omitempty"`
+	Attempts  int          `json:"attempts,omitempty"`
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	EndTime   *metav1.Time `json:"endTime,omitempty"`
+	Message   string       `json:"message,omitempty"`
+	OutputRef string       `json:"outputRef,omitempty"`
+	// BranchStatuses records the per-branch result when this step is a Parallel fan-out.
+	BranchStatuses []StepStatus `json:"branchStatuses,omitempty"`
+}
+
 // WorkflowRun
 type WorkflowRunSpec struct {
 	// Important: Run "make" to regenerate code after modifying this file
@@ -27,15 +59,43 @@ type WorkflowRunSpec struct {
 	Agents   []string `json:"agents,omitempty"`
 	Workflow string   `json:"workflow,omitempty"`
 	LogLevel string   `json:"loglevel,omitempty"`
+	// Input overrides the referenced Workflow's root input, e.g. to bind an event payload that
+	// triggered this run.
+	Input Input `json:"input,omitempty"`
 }
 
 type WorkflowRunStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
+
+	// Phase is a high-level summary of where the WorkflowRun is in its lifecycle.
+	Phase Phase `json:"phase,omitempty"`
+	// ObservedGeneration is the most recent generation of this WorkflowRun observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions represent the latest available observations of the WorkflowRun's state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+	// StartTime is when the controller first started reconciling this WorkflowRun.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is when the WorkflowRun reached a terminal phase.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// StepStatuses reports the status of each step of the referenced Workflow.
+	StepStatuses []StepStatus `json:"stepStatuses,omitempty"`
+	// CurrentStep names the step presently executing, and is cleared once the WorkflowRun
+	// reaches a terminal phase. The controller patches this (and StepStatuses) after every step,
+	// not just once at the end, so it reflects an in-progress run.
+	CurrentStep string `json:"currentStep,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Step",type=string,JSONPath=`.status.currentStep`
+// +kubebuilder:printcolumn:name="Workflow",type=string,JSONPath=`.spec.workflow`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 type WorkflowRun struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -56,31 +116,61 @@ type WorkflowRunList struct {
 // Workflow
 
 type Input struct {
-	Prompt   string `json:"prompt,omitempty"`
+	Prompt string `json:"prompt,omitempty"`
+	// Template is rendered with Go text/template plus sprig functions against prior step
+	// outputs, workflow inputs and metadata.
 	Template string `json:"template,omitempty"`
 }
 type Loop struct {
-	Agent  string `json:"agent,omitempty"`
+	Agent string `json:"agent,omitempty"`
+	// Until is a CEL expression; the loop stops once it evaluates to true.
 	Until string `json:"until,omitempty"`
+	// MaxIterations bounds how many times the loop may run even if Until never evaluates to
+	// true, to prevent runaway loops. Zero means unset, in which case the controller applies
+	// its own default cap.
+	MaxIterations int `json:"maxIterations,omitempty"`
 }
 type Condition struct {
-	If      string `json:"if,omitempty"`
-	Then    string `json:"then,omitempty"`
-	Else    string `json:"else,omitempty"`
+	// If is a CEL expression evaluated against prior step outputs, workflow inputs and metadata.
+	If   string `json:"if,omitempty"`
+	Then string `json:"then,omitempty"`
+	Else string `json:"else,omitempty"`
+	// Case is a CEL expression selecting Do when true, Default otherwise.
 	Case    string `json:"case,omitempty"`
 	Do      string `json:"do,omitempty"`
 	Default string `json:"default,omitempty"`
 }
-//type Parallel struct {
-//	Agent string `json:"agent,omitempty"` // ???
-//}
+// Ref points a Step at a node to invoke other than an Agent, e.g. a PromptTemplate, LLMChain,
+// Tool or VectorStore. Kind must match one of those node CRD kinds.
+type Ref struct {
+	Kind string `json:"kind,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// ParallelSpec fans a step out into concurrently executed branches and aggregates their
+// outputs into the step's own output.
+type ParallelSpec struct {
+	// Branches are executed concurrently; each branch is itself a full Step.
+	Branches []Step `json:"branches,omitempty"`
+	// MaxConcurrency bounds how many branches run at once. Zero means unbounded.
+	MaxConcurrency int `json:"maxConcurrency,omitempty"`
+	// Aggregation selects how branch outputs are combined: merge|list|first|majority.
+	Aggregation string `json:"aggregation,omitempty"`
+	// FailurePolicy controls how a branch failure affects its siblings: failFast|continue|threshold.
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+	// Threshold is the minimum number of successful branches required when FailurePolicy is "threshold".
+	Threshold int `json:"threshold,omitempty"`
+}
+
 type Step struct {
-	Name      string      `json:"name,omitempty"`
-	Agent     string      `json:"agent,omitempty"`
-	Input     Input       `json:"input,omitempty"`
-	Loop      Loop        `json:"loop,omitempty"`
-	Condition []Condition `json:"condition,omitempty"`
-	Parallel  []string  `json:"parallel,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Agent string `json:"agent,omitempty"`
+	// Ref calls a node of a kind other than Agent, wiring its typed outputs into downstream steps.
+	Ref       Ref          `json:"ref,omitempty"`
+	Input     Input        `json:"input,omitempty"`
+	Loop      Loop         `json:"loop,omitempty"`
+	Condition []Condition  `json:"condition,omitempty"`
+	Parallel  ParallelSpec `json:"parallel,omitempty"`
 }
 type Exception struct {
 	Name  string `json:"name,omitempty"`
@@ -113,10 +203,23 @@ type WorkflowSpec struct {
 type WorkflowStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
+
+	// Phase summarizes the most recent WorkflowRun created from this Workflow.
+	Phase Phase `json:"phase,omitempty"`
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions represent the latest available observations of the Workflow's state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 type Workflow struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -145,18 +248,39 @@ type AgentSpec struct {
 	Tools        []string `json:"tools,omitempty"`
 	Instructions string   `json:"instructions,omitempty"`
 	Code         string   `json:"code,omitempty"`
-	Input        string   `json:"input,omitempty"`
-	Output       string   `json:"oputput,omitempty"`
-	Url          string   `json:"url,omitempty"`
+	// Input is a JSON Schema the controller validates a step's rendered input against before
+	// invoking this agent.
+	Input apiextensionsv1.JSON `json:"input,omitempty"`
+	// Output is a JSON Schema the controller validates this agent's output against.
+	Output apiextensionsv1.JSON `json:"oputput,omitempty"`
+	Url    string               `json:"url,omitempty"`
 }
 
 type AgentStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
+
+	// Ready is true once the agent's endpoint has passed its health check.
+	Ready bool `json:"ready,omitempty"`
+	// LastProbeTime is the last time the agent's endpoint was probed.
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+	// URL is the resolved endpoint the agent can be invoked on.
+	URL string `json:"url,omitempty"`
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions represent the latest available observations of the Agent's state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=`.status.url`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 type Agent struct {
 	metav1.TypeMeta   `json:",inline"`
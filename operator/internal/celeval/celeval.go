@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package celeval evaluates the CEL expressions that appear in a Workflow (Condition.If,
+// Condition.Case, Loop.Until) and renders Input.Template, all against a shared Context of prior
+// step outputs, workflow inputs and run metadata.
+package celeval
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/cel-go/cel"
+)
+
+// DefaultTimeout bounds a single CEL evaluation so a pathological expression cannot hang a
+// reconcile loop.
+const DefaultTimeout = 5 * time.Second
+
+// Context is the evaluation environment shared by every CEL expression and template in a
+// WorkflowRun: prior steps' outputs keyed by step name, the workflow's root input, and
+// free-form run metadata (e.g. trigger annotations).
+type Context struct {
+	Steps    map[string]interface{}
+	Input    map[string]interface{}
+	Metadata map[string]interface{}
+}
+
+func (c Context) vars() map[string]interface{} {
+	return map[string]interface{}{
+		"steps":    c.Steps,
+		"input":    c.Input,
+		"metadata": c.Metadata,
+	}
+}
+
+func newEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("steps", cel.DynType),
+		cel.Variable("input", cel.DynType),
+		cel.Variable("metadata", cel.DynType),
+	)
+}
+
+// Compile parses and type-checks a CEL expression against the standard steps/input/metadata
+// environment, without evaluating it. Used by the admission webhook to reject bad expressions
+// at `kubectl apply` time.
+func Compile(expr string) (cel.Program, error) {
+	env, err := newEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	// Interrupt checks let ContextEval honor its context deadline inside comprehensions.
+	return env.Program(ast, cel.InterruptCheckFrequency(100))
+}
+
+// EvalBool compiles and evaluates expr, bounded by DefaultTimeout, and requires the result to
+// be a bool (as Condition.If, Condition.Case and Loop.Until all are).
+func EvalBool(ctx context.Context, expr string, evalCtx Context) (bool, error) {
+	prg, err := Compile(expr)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	out, _, err := prg.ContextEval(ctx, evalCtx.vars())
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression %q did not evaluate to a bool", expr)
+	}
+	return b, nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celeval
+
+import (
+	"context"
+	"testing"
+)
+
+func TestEvalBoolTrueAndFalse(t *testing.T) {
+	evalCtx := Context{
+		Steps: map[string]interface{}{"retrieve": map[string]interface{}{"documents": []interface{}{"a", "b"}}},
+		Input: map[string]interface{}{"prompt": "hello"},
+	}
+
+	ok, err := EvalBool(context.Background(), `size(steps.retrieve.documents) > 0`, evalCtx)
+	if err != nil {
+		t.Fatalf("EvalBool returned error: %v", err)
+	}
+	if !ok {
+		t.Error("expected expression to evaluate true")
+	}
+
+	ok, err = EvalBool(context.Background(), `size(steps.retrieve.documents) > 10`, evalCtx)
+	if err != nil {
+		t.Fatalf("EvalBool returned error: %v", err)
+	}
+	if ok {
+		t.Error("expected expression to evaluate false")
+	}
+}
+
+func TestEvalBoolNonBoolResult(t *testing.T) {
+	if _, err := EvalBool(context.Background(), `input.prompt`, Context{Input: map[string]interface{}{"prompt": "hello"}}); err == nil {
+		t.Fatal("expected an error for a non-bool expression result")
+	}
+}
+
+func TestEvalBoolInvalidExpression(t *testing.T) {
+	if _, err := EvalBool(context.Background(), `not valid cel (`, Context{}); err == nil {
+		t.Fatal("expected an error for an invalid expression")
+	}
+}
+
+func TestCompileValidatesWithoutEvaluating(t *testing.T) {
+	if _, err := Compile(`steps.a.output == "done"`); err != nil {
+		t.Fatalf("Compile returned error for a valid expression: %v", err)
+	}
+	if _, err := Compile(`this is not cel`); err == nil {
+		t.Fatal("expected Compile to reject an invalid expression")
+	}
+}
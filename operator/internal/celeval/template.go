@@ -0,0 +1,44 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celeval
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+)
+
+// ParseTemplate parses src as a Go text/template with the sprig function library, without
+// rendering it. Used by the admission webhook to catch malformed templates early.
+func ParseTemplate(src string) (*template.Template, error) {
+	return template.New("input").Funcs(sprig.TxtFuncMap()).Parse(src)
+}
+
+// RenderTemplate renders src against evalCtx's steps/input/metadata.
+func RenderTemplate(src string, evalCtx Context) (string, error) {
+	tmpl, err := ParseTemplate(src)
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, evalCtx.vars()); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
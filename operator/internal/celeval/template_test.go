@@ -0,0 +1,49 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celeval
+
+import "testing"
+
+func TestRenderTemplate(t *testing.T) {
+	evalCtx := Context{
+		Steps: map[string]interface{}{"retrieve": map[string]interface{}{"documents": "docs"}},
+		Input: map[string]interface{}{"prompt": "hello"},
+	}
+
+	out, err := RenderTemplate(`{{ .input.prompt | upper }}: {{ .steps.retrieve.documents }}`, evalCtx)
+	if err != nil {
+		t.Fatalf("RenderTemplate returned error: %v", err)
+	}
+	if out != "HELLO: docs" {
+		t.Errorf("expected %q, got %q", "HELLO: docs", out)
+	}
+}
+
+func TestRenderTemplateParseError(t *testing.T) {
+	if _, err := RenderTemplate(`{{ .input.prompt`, Context{}); err == nil {
+		t.Fatal("expected an error for a malformed template")
+	}
+}
+
+func TestParseTemplate(t *testing.T) {
+	if _, err := ParseTemplate(`{{ .input.prompt }}`); err != nil {
+		t.Fatalf("ParseTemplate returned error for valid source: %v", err)
+	}
+	if _, err := ParseTemplate(`{{ .input.prompt`); err == nil {
+		t.Fatal("expected ParseTemplate to reject malformed source")
+	}
+}
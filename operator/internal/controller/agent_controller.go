@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+	"github.com/i-am-bee/beeai-labs/operator/pkg/agentruntime"
+)
+
+// AgentReconciler reconciles an Agent object.
+type AgentReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=beeai.dev,resources=agents,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=beeai.dev,resources=agents/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=beeai.dev,resources=agents/finalizers,verbs=update
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+func (r *AgentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	agent := &beeaiv1alpha1.Agent{}
+	if err := r.Get(ctx, req.NamespacedName, agent); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	patch := client.MergeFrom(agent.DeepCopy())
+
+	agent.Status.ObservedGeneration = agent.Generation
+	now := metav1.Now()
+	agent.Status.LastProbeTime = &now
+
+	rt, err := agentruntime.Get(agent.Spec.Framework)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileWorkload(ctx, agent, rt); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if agent.Status.URL != "" {
+		resolved := agentruntime.ResolvedSpec(agent.Spec, agent.Status.URL)
+		agent.Status.Ready = rt.HealthCheck(ctx, resolved) == nil
+	}
+
+	condStatus := metav1.ConditionFalse
+	reason := "EndpointNotReady"
+	if agent.Status.Ready {
+		condStatus = metav1.ConditionTrue
+		reason = "EndpointReady"
+	}
+	meta.SetStatusCondition(&agent.Status.Conditions, metav1.Condition{
+		Type:               beeaiv1alpha1.ConditionTypeReady,
+		Status:             condStatus,
+		Reason:             reason,
+		Message:            "agent endpoint " + agent.Status.URL,
+		ObservedGeneration: agent.Generation,
+	})
+
+	if err := r.Status().Patch(ctx, agent, patch); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// reconcileWorkload ensures a Deployment+Service exist for frameworks that run their own
+// server (i.e. ContainerImage is non-empty), and resolves AgentStatus.URL either to that
+// Service or, for the "http" framework, straight to AgentSpec.Url.
+func (r *AgentReconciler) reconcileWorkload(ctx context.Context, agent *beeaiv1alpha1.Agent, rt agentruntime.Runtime) error {
+	image := rt.ContainerImage(agent.Spec)
+	if image == "" {
+		agent.Status.URL = agent.Spec.Url
+		return nil
+	}
+
+	port := rt.ContainerPort()
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: agent.Name, Namespace: agent.Namespace},
+	}
+	if _, err := ctrl.CreateOrUpdate(ctx, r.Client, deployment, func() error {
+		replicas := int32(1)
+		labels := map[string]string{"beeai.dev/agent": agent.Name}
+		deployment.Spec = appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  "agent",
+						Image: image,
+						Ports: []corev1.ContainerPort{{ContainerPort: port}},
+						Env:   agentruntime.EnvFor(agent.Spec),
+					}},
+				},
+			},
+		}
+		return controllerutil.SetControllerReference(agent, deployment, r.Scheme)
+	}); err != nil {
+		return err
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: agent.Name, Namespace: agent.Namespace},
+	}
+	if _, err := ctrl.CreateOrUpdate(ctx, r.Client, service, func() error {
+		service.Spec.Selector = map[string]string{"beeai.dev/agent": agent.Name}
+		service.Spec.Ports = []corev1.ServicePort{{
+			Port:       port,
+			TargetPort: intstr.FromInt(int(port)),
+		}}
+		return controllerutil.SetControllerReference(agent, service, r.Scheme)
+	}); err != nil {
+		return err
+	}
+
+	agent.Status.URL = fmt.Sprintf("http://%s.%s.svc.cluster.local:%d", service.Name, service.Namespace, port)
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AgentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&beeaiv1alpha1.Agent{}).
+		Owns(&appsv1.Deployment{}).
+		Owns(&corev1.Service{}).
+		Complete(r)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+// CronReconciler watches Workflows with Event.Cron set and schedules a tick that creates a
+// fresh WorkflowRun, owned by the Workflow, every time the cron expression fires.
+type CronReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	mu      sync.Mutex
+	cron    *cron.Cron
+	entries map[types.NamespacedName]cron.EntryID
+}
+
+// +kubebuilder:rbac:groups=beeai.dev,resources=workflows,verbs=get;list;watch
+// +kubebuilder:rbac:groups=beeai.dev,resources=workflowruns,verbs=get;list;watch;create
+
+func (r *CronReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	workflow := &beeaiv1alpha1.Workflow{}
+	if err := r.Get(ctx, req.NamespacedName, workflow); err != nil {
+		r.unschedule(req.NamespacedName)
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	cronExpr := workflow.Spec.Template.Event.Cron
+	if cronExpr == "" {
+		r.unschedule(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if id, ok := r.entries[req.NamespacedName]; ok {
+		r.cron.Remove(id)
+	}
+
+	name := req.NamespacedName
+	id, err := r.cron.AddFunc(cronExpr, func() {
+		if err := r.fire(context.Background(), name); err != nil {
+			logger.Error(err, "failed to create WorkflowRun for cron trigger", "workflow", name)
+		}
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("invalid cron expression %q: %w", cronExpr, err)
+	}
+	r.entries[req.NamespacedName] = id
+
+	return ctrl.Result{}, nil
+}
+
+func (r *CronReconciler) unschedule(name types.NamespacedName) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if id, ok := r.entries[name]; ok {
+		r.cron.Remove(id)
+		delete(r.entries, name)
+	}
+}
+
+func (r *CronReconciler) fire(ctx context.Context, name types.NamespacedName) error {
+	workflow := &beeaiv1alpha1.Workflow{}
+	if err := r.Get(ctx, name, workflow); err != nil {
+		return err
+	}
+
+	run := &beeaiv1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: workflow.Name + "-",
+			Namespace:    workflow.Namespace,
+			Annotations: map[string]string{
+				"beeai.dev/trigger-source": "cron",
+				"beeai.dev/trigger-id":     fmt.Sprintf("%s/%d", workflow.Name, time.Now().UnixNano()),
+				"beeai.dev/trigger-time":   time.Now().Format(time.RFC3339),
+			},
+		},
+		Spec: beeaiv1alpha1.WorkflowRunSpec{
+			Workflow: workflow.Name,
+		},
+	}
+	if err := controllerutil.SetControllerReference(workflow, run, r.Scheme); err != nil {
+		return err
+	}
+	return r.Create(ctx, run)
+}
+
+// SetupWithManager sets up the controller with the Manager and starts the cron scheduler.
+func (r *CronReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.cron = cron.New()
+	r.entries = make(map[types.NamespacedName]cron.EntryID)
+
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		r.cron.Start()
+		<-ctx.Done()
+		stopCtx := r.cron.Stop()
+		<-stopCtx.Done()
+		return nil
+	})); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&beeaiv1alpha1.Workflow{}).
+		Complete(r)
+}
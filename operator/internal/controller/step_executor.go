@@ -0,0 +1,375 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+	"github.com/i-am-bee/beeai-labs/operator/internal/celeval"
+	"github.com/i-am-bee/beeai-labs/operator/internal/dag"
+	"github.com/i-am-bee/beeai-labs/operator/internal/parallel"
+	"github.com/i-am-bee/beeai-labs/operator/pkg/agentruntime"
+)
+
+// defaultMaxIterations caps a Loop step when Loop.MaxIterations is unset, so a loop whose Until
+// expression never turns true cannot run forever.
+const defaultMaxIterations = 10
+
+// agentInvokeTimeout bounds a single agent call. The reconcile context it would otherwise inherit
+// has no deadline of its own, so without this an unresponsive agent endpoint hangs the whole
+// WorkflowRun reconcile.
+const agentInvokeTimeout = 2 * time.Minute
+
+// stepExecutor runs a Workflow's steps, in the dependency order dag.Resolve produces, for a
+// single WorkflowRun. It invokes agents via agentruntime, fans Parallel steps out via the
+// parallel package, and records every step's outcome onto WorkflowRunStatus.StepStatuses,
+// patching the API server after each step so progress is visible while a run is still in flight.
+type stepExecutor struct {
+	client.Client
+	run      *beeaiv1alpha1.WorkflowRun
+	workflow *beeaiv1alpha1.Workflow
+
+	// lastPatched is the most recent snapshot of run that was successfully persisted, used as the
+	// base for the next incremental client.MergeFrom patch.
+	lastPatched *beeaiv1alpha1.WorkflowRun
+}
+
+// execute runs every step of the Workflow template in dependency order and returns the run's
+// terminal phase. It stops at the first step that fails.
+func (e *stepExecutor) execute(ctx context.Context) (beeaiv1alpha1.Phase, error) {
+	steps := e.workflow.Spec.Template.Steps
+
+	graph, err := dag.Resolve(steps)
+	if err != nil {
+		return beeaiv1alpha1.PhaseFailed, fmt.Errorf("resolving step graph: %w", err)
+	}
+
+	byName := make(map[string]beeaiv1alpha1.Step, len(steps))
+	for _, s := range steps {
+		byName[s.Name] = s
+	}
+
+	e.lastPatched = e.run.DeepCopy()
+	outputs := make(map[string]interface{}, len(steps))
+
+	for _, name := range graph.Order {
+		e.run.Status.CurrentStep = name
+		if err := e.patchStatus(ctx); err != nil {
+			return beeaiv1alpha1.PhaseFailed, fmt.Errorf("patching status before step %q: %w", name, err)
+		}
+
+		status, stepErr := e.runStep(ctx, byName[name], outputs)
+		e.setStepStatus(status)
+		e.run.Status.CurrentStep = ""
+		if err := e.patchStatus(ctx); err != nil && stepErr == nil {
+			stepErr = fmt.Errorf("patching status after step %q: %w", name, err)
+		}
+		if stepErr != nil {
+			return beeaiv1alpha1.PhaseFailed, stepErr
+		}
+		outputs[status.Name] = map[string]interface{}{"output": status.OutputRef}
+	}
+
+	return beeaiv1alpha1.PhaseSucceeded, nil
+}
+
+// patchStatus persists run's current status immediately against the last-persisted snapshot, so
+// CurrentStep and StepStatuses are observable mid-run instead of only once execute returns.
+func (e *stepExecutor) patchStatus(ctx context.Context) error {
+	patch := client.MergeFrom(e.lastPatched)
+	if err := e.Status().Patch(ctx, e.run, patch); err != nil {
+		return err
+	}
+	e.lastPatched = e.run.DeepCopy()
+	return nil
+}
+
+func (e *stepExecutor) runStep(ctx context.Context, step beeaiv1alpha1.Step, outputs map[string]interface{}) (beeaiv1alpha1.StepStatus, error) {
+	start := metav1.Now()
+	status := beeaiv1alpha1.StepStatus{Name: step.Name, Phase: beeaiv1alpha1.PhaseRunning, StartTime: &start}
+
+	prompt := e.run.Spec.Input.Prompt
+	if prompt == "" {
+		prompt = e.workflow.Spec.Template.Prompt
+	}
+	evalCtx := celeval.Context{
+		Steps:    outputs,
+		Input:    map[string]interface{}{"prompt": prompt},
+		Metadata: map[string]interface{}{"workflowRun": e.run.Name},
+	}
+
+	for _, cond := range step.Condition {
+		if cond.If == "" {
+			continue
+		}
+		ok, err := celeval.EvalBool(ctx, cond.If, evalCtx)
+		if err != nil {
+			return e.finish(status, "", fmt.Errorf("condition.if: %w", err))
+		}
+		if !ok {
+			status.Message = "condition.if evaluated false; step skipped"
+			return e.finish(status, "", nil)
+		}
+	}
+	for _, cond := range step.Condition {
+		if cond.Case == "" {
+			continue
+		}
+		ok, err := celeval.EvalBool(ctx, cond.Case, evalCtx)
+		if err != nil {
+			return e.finish(status, "", fmt.Errorf("condition.case: %w", err))
+		}
+		status.Message = cond.Default
+		if ok {
+			status.Message = cond.Do
+		}
+	}
+
+	switch {
+	case len(step.Parallel.Branches) > 0:
+		return e.runParallel(ctx, step, status, outputs)
+	case step.Loop.Agent != "":
+		output, attempts, err := e.runLoop(ctx, step, evalCtx)
+		status.Attempts = attempts
+		return e.finish(status, output, err)
+	case step.Agent != "":
+		output, err := e.invokeAgent(ctx, step.Agent, step.Input, evalCtx)
+		status.Attempts = 1
+		return e.finish(status, output, err)
+	case step.Ref.Kind != "":
+		output, err := e.invokeRef(ctx, step.Ref, step.Input, evalCtx)
+		status.Attempts = 1
+		return e.finish(status, output, err)
+	default:
+		status.Message = "step has no agent, ref, loop or parallel branches; nothing to execute"
+		return e.finish(status, "", nil)
+	}
+}
+
+// invokeRef dispatches a Step.Ref to the referenced node kind. PromptTemplate and LLMChain render
+// and (for LLMChain) invoke their target Agent; Tool posts the rendered input to its configured
+// endpoint; VectorStore has no invocable action of its own in this schema, so referencing one
+// simply resolves its backend address for a downstream Tool step to use.
+func (e *stepExecutor) invokeRef(ctx context.Context, ref beeaiv1alpha1.Ref, input beeaiv1alpha1.Input, evalCtx celeval.Context) (string, error) {
+	switch ref.Kind {
+	case "PromptTemplate":
+		return e.renderPromptTemplate(ctx, ref.Name, evalCtx)
+	case "LLMChain":
+		return e.invokeLLMChain(ctx, ref.Name, evalCtx)
+	case "Tool":
+		return e.invokeTool(ctx, ref.Name, input, evalCtx)
+	case "VectorStore":
+		return e.resolveVectorStore(ctx, ref.Name)
+	default:
+		return "", fmt.Errorf("ref.kind %q is not a supported node kind", ref.Kind)
+	}
+}
+
+func (e *stepExecutor) renderPromptTemplate(ctx context.Context, name string, evalCtx celeval.Context) (string, error) {
+	tmpl := &beeaiv1alpha1.PromptTemplate{}
+	if err := e.Get(ctx, client.ObjectKey{Namespace: e.run.Namespace, Name: name}, tmpl); err != nil {
+		return "", fmt.Errorf("fetching PromptTemplate %q: %w", name, err)
+	}
+	out, err := celeval.RenderTemplate(tmpl.Spec.Body, evalCtx)
+	if err != nil {
+		return "", fmt.Errorf("rendering PromptTemplate %q: %w", name, err)
+	}
+	return out, nil
+}
+
+func (e *stepExecutor) invokeLLMChain(ctx context.Context, name string, evalCtx celeval.Context) (string, error) {
+	chain := &beeaiv1alpha1.LLMChain{}
+	if err := e.Get(ctx, client.ObjectKey{Namespace: e.run.Namespace, Name: name}, chain); err != nil {
+		return "", fmt.Errorf("fetching LLMChain %q: %w", name, err)
+	}
+
+	prompt := ""
+	if chain.Spec.Template.Kind != "" {
+		rendered, err := e.invokeRef(ctx, chain.Spec.Template, beeaiv1alpha1.Input{}, evalCtx)
+		if err != nil {
+			return "", fmt.Errorf("rendering LLMChain %q template: %w", name, err)
+		}
+		prompt = rendered
+	}
+
+	out, err := e.invokeAgent(ctx, chain.Spec.Model, beeaiv1alpha1.Input{Prompt: prompt}, evalCtx)
+	if err != nil {
+		return "", fmt.Errorf("invoking LLMChain %q: %w", name, err)
+	}
+	return out, nil
+}
+
+func (e *stepExecutor) invokeTool(ctx context.Context, name string, input beeaiv1alpha1.Input, evalCtx celeval.Context) (string, error) {
+	tool := &beeaiv1alpha1.Tool{}
+	if err := e.Get(ctx, client.ObjectKey{Namespace: e.run.Namespace, Name: name}, tool); err != nil {
+		return "", fmt.Errorf("fetching Tool %q: %w", name, err)
+	}
+
+	prompt := input.Prompt
+	if input.Template != "" {
+		rendered, err := celeval.RenderTemplate(input.Template, evalCtx)
+		if err != nil {
+			return "", fmt.Errorf("input.template: %w", err)
+		}
+		prompt = rendered
+	}
+
+	timeout := agentInvokeTimeout
+	if tool.Spec.Invocation.Timeout != "" {
+		d, err := time.ParseDuration(tool.Spec.Invocation.Timeout)
+		if err != nil {
+			return "", fmt.Errorf("Tool %q invocation.timeout: %w", name, err)
+		}
+		timeout = d
+	}
+
+	invokeCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	out, err := agentruntime.InvokeURL(invokeCtx, tool.Spec.Invocation.Url, agentruntime.Input{Prompt: prompt})
+	if err != nil {
+		return "", fmt.Errorf("invoking Tool %q: %w", name, err)
+	}
+	return out.Text, nil
+}
+
+func (e *stepExecutor) resolveVectorStore(ctx context.Context, name string) (string, error) {
+	store := &beeaiv1alpha1.VectorStore{}
+	if err := e.Get(ctx, client.ObjectKey{Namespace: e.run.Namespace, Name: name}, store); err != nil {
+		return "", fmt.Errorf("fetching VectorStore %q: %w", name, err)
+	}
+	return store.Spec.BackendUri, nil
+}
+
+func (e *stepExecutor) runParallel(ctx context.Context, step beeaiv1alpha1.Step, status beeaiv1alpha1.StepStatus, outputs map[string]interface{}) (beeaiv1alpha1.StepStatus, error) {
+	result, err := parallel.Run(ctx, step.Parallel, func(ctx context.Context, branch beeaiv1alpha1.Step) (string, error) {
+		branchStatus, err := e.runStep(ctx, branch, outputs)
+		return branchStatus.OutputRef, err
+	})
+	status.BranchStatuses = make([]beeaiv1alpha1.StepStatus, len(result.Branches))
+	for i, b := range result.Branches {
+		status.BranchStatuses[i] = b.Status
+	}
+	return e.finish(status, result.Output, err)
+}
+
+func (e *stepExecutor) runLoop(ctx context.Context, step beeaiv1alpha1.Step, evalCtx celeval.Context) (string, int, error) {
+	maxIterations := step.Loop.MaxIterations
+	if maxIterations <= 0 {
+		maxIterations = defaultMaxIterations
+	}
+
+	var output string
+	for attempt := 1; attempt <= maxIterations; attempt++ {
+		out, err := e.invokeAgent(ctx, step.Loop.Agent, step.Input, evalCtx)
+		if err != nil {
+			return "", attempt, err
+		}
+		output = out
+
+		if step.Loop.Until == "" {
+			return output, attempt, nil
+		}
+
+		loopCtx := evalCtx
+		loopCtx.Steps = withOutput(evalCtx.Steps, step.Name, output)
+		done, err := celeval.EvalBool(ctx, step.Loop.Until, loopCtx)
+		if err != nil {
+			return "", attempt, fmt.Errorf("loop.until: %w", err)
+		}
+		if done {
+			return output, attempt, nil
+		}
+	}
+	return output, maxIterations, fmt.Errorf("loop step %q did not satisfy loop.until within %d iterations", step.Name, maxIterations)
+}
+
+func (e *stepExecutor) invokeAgent(ctx context.Context, agentName string, input beeaiv1alpha1.Input, evalCtx celeval.Context) (string, error) {
+	agent := &beeaiv1alpha1.Agent{}
+	if err := e.Get(ctx, client.ObjectKey{Namespace: e.run.Namespace, Name: agentName}, agent); err != nil {
+		return "", fmt.Errorf("fetching agent %q: %w", agentName, err)
+	}
+
+	rt, err := agentruntime.Get(agent.Spec.Framework)
+	if err != nil {
+		return "", err
+	}
+
+	prompt := input.Prompt
+	if input.Template != "" {
+		prompt, err = celeval.RenderTemplate(input.Template, evalCtx)
+		if err != nil {
+			return "", fmt.Errorf("input.template: %w", err)
+		}
+	}
+	if err := agentruntime.ValidateInput(agent.Spec, prompt); err != nil {
+		return "", fmt.Errorf("agent %q: %w", agentName, err)
+	}
+
+	invokeCtx, cancel := context.WithTimeout(ctx, agentInvokeTimeout)
+	defer cancel()
+
+	resolved := agentruntime.ResolvedSpec(agent.Spec, agent.Status.URL)
+	out, err := rt.Invoke(invokeCtx, resolved, agentruntime.Input{Prompt: prompt})
+	if err != nil {
+		return "", fmt.Errorf("invoking agent %q: %w", agentName, err)
+	}
+	if err := agentruntime.ValidateOutput(agent.Spec, out.Text); err != nil {
+		return "", fmt.Errorf("agent %q: %w", agentName, err)
+	}
+	return out.Text, nil
+}
+
+func (e *stepExecutor) finish(status beeaiv1alpha1.StepStatus, output string, err error) (beeaiv1alpha1.StepStatus, error) {
+	end := metav1.Now()
+	status.EndTime = &end
+	status.OutputRef = output
+	if err != nil {
+		status.Phase = beeaiv1alpha1.PhaseFailed
+		status.Message = err.Error()
+		return status, err
+	}
+	if status.Phase == beeaiv1alpha1.PhaseRunning {
+		status.Phase = beeaiv1alpha1.PhaseSucceeded
+	}
+	return status, nil
+}
+
+func (e *stepExecutor) setStepStatus(status beeaiv1alpha1.StepStatus) {
+	for i, s := range e.run.Status.StepStatuses {
+		if s.Name == status.Name {
+			e.run.Status.StepStatuses[i] = status
+			return
+		}
+	}
+	e.run.Status.StepStatuses = append(e.run.Status.StepStatuses, status)
+}
+
+func withOutput(steps map[string]interface{}, name, output string) map[string]interface{} {
+	merged := make(map[string]interface{}, len(steps)+1)
+	for k, v := range steps {
+		merged[k] = v
+	}
+	merged[name] = map[string]interface{}{"output": output}
+	return merged
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+// WorkflowReconciler reconciles a Workflow object.
+type WorkflowReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=beeai.dev,resources=workflows,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=beeai.dev,resources=workflows/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=beeai.dev,resources=workflows/finalizers,verbs=update
+
+func (r *WorkflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	workflow := &beeaiv1alpha1.Workflow{}
+	if err := r.Get(ctx, req.NamespacedName, workflow); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	patch := client.MergeFrom(workflow.DeepCopy())
+
+	workflow.Status.ObservedGeneration = workflow.Generation
+	if workflow.Status.Phase == "" {
+		workflow.Status.Phase = beeaiv1alpha1.PhasePending
+	}
+
+	var runs beeaiv1alpha1.WorkflowRunList
+	if err := r.List(ctx, &runs, client.InNamespace(workflow.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+	if latest := latestRun(runs.Items, workflow.Name); latest != nil {
+		workflow.Status.Phase = latest.Status.Phase
+	}
+
+	meta.SetStatusCondition(&workflow.Status.Conditions, metav1.Condition{
+		Type:               beeaiv1alpha1.ConditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Validated",
+		Message:            "Workflow template is valid",
+		ObservedGeneration: workflow.Generation,
+	})
+
+	if err := r.Status().Patch(ctx, workflow, patch); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *WorkflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&beeaiv1alpha1.Workflow{}).
+		Complete(r)
+}
+
+// latestRun returns the most recently created WorkflowRun targeting workflowName, or nil if none
+// do. Used instead of iterating runs in List order, which is unordered and would otherwise make
+// Workflow.Status.Phase non-deterministic whenever more than one run exists.
+func latestRun(runs []beeaiv1alpha1.WorkflowRun, workflowName string) *beeaiv1alpha1.WorkflowRun {
+	var latest *beeaiv1alpha1.WorkflowRun
+	for i := range runs {
+		run := &runs[i]
+		if run.Spec.Workflow != workflowName {
+			continue
+		}
+		if latest == nil || run.CreationTimestamp.After(latest.CreationTimestamp.Time) {
+			latest = run
+		}
+	}
+	return latest
+}
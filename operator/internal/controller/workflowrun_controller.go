@@ -0,0 +1,143 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+// WorkflowRunReconciler reconciles a WorkflowRun object.
+type WorkflowRunReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=beeai.dev,resources=workflowruns,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=beeai.dev,resources=workflowruns/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=beeai.dev,resources=workflowruns/finalizers,verbs=update
+
+func (r *WorkflowRunReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	run := &beeaiv1alpha1.WorkflowRun{}
+	if err := r.Get(ctx, req.NamespacedName, run); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if isTerminal(run.Status.Phase) {
+		return ctrl.Result{}, nil
+	}
+
+	patch := client.MergeFrom(run.DeepCopy())
+
+	if run.Status.Phase == "" {
+		run.Status.Phase = beeaiv1alpha1.PhasePending
+		now := metav1.Now()
+		run.Status.StartTime = &now
+	}
+	run.Status.ObservedGeneration = run.Generation
+
+	workflow := &beeaiv1alpha1.Workflow{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: run.Namespace, Name: run.Spec.Workflow}, workflow); err != nil {
+		if apierrors.IsNotFound(err) {
+			run.Status.Phase = beeaiv1alpha1.PhaseFailed
+			meta.SetStatusCondition(&run.Status.Conditions, metav1.Condition{
+				Type:               beeaiv1alpha1.ConditionTypeDegraded,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WorkflowNotFound",
+				Message:            "referenced Workflow " + run.Spec.Workflow + " was not found",
+				ObservedGeneration: run.Generation,
+			})
+			if statusErr := r.Status().Patch(ctx, run, patch); statusErr != nil {
+				return ctrl.Result{}, statusErr
+			}
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	run.Status.Phase = beeaiv1alpha1.PhaseRunning
+	if err := r.Status().Patch(ctx, run, patch); err != nil {
+		logger.Error(err, "unable to patch WorkflowRun status")
+		return ctrl.Result{}, err
+	}
+	// exec patches status itself after every step, so kubectl can observe Phase/CurrentStep
+	// change while the run is still executing, rather than only once it reaches a terminal phase.
+	patch = client.MergeFrom(run.DeepCopy())
+
+	exec := &stepExecutor{Client: r.Client, run: run, workflow: workflow}
+	phase, execErr := exec.execute(ctx)
+	run.Status.Phase = phase
+	completed := metav1.Now()
+	run.Status.CompletionTime = &completed
+
+	progressingStatus := metav1.Condition{
+		Type:               beeaiv1alpha1.ConditionTypeProgressing,
+		Status:             metav1.ConditionFalse,
+		Reason:             "Completed",
+		Message:            "WorkflowRun has finished executing",
+		ObservedGeneration: run.Generation,
+	}
+	readyCondition := metav1.Condition{
+		Type:               beeaiv1alpha1.ConditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Succeeded",
+		Message:            "WorkflowRun completed successfully",
+		ObservedGeneration: run.Generation,
+	}
+	if execErr != nil {
+		readyCondition.Status = metav1.ConditionFalse
+		readyCondition.Reason = "Failed"
+		readyCondition.Message = execErr.Error()
+		logger.Error(execErr, "WorkflowRun step execution failed")
+	}
+	meta.SetStatusCondition(&run.Status.Conditions, progressingStatus)
+	meta.SetStatusCondition(&run.Status.Conditions, readyCondition)
+
+	if err := r.Status().Patch(ctx, run, patch); err != nil {
+		logger.Error(err, "unable to patch WorkflowRun status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *WorkflowRunReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&beeaiv1alpha1.WorkflowRun{}).
+		Complete(r)
+}
+
+// isTerminal reports whether phase is one a WorkflowRun never leaves once reached.
+func isTerminal(phase beeaiv1alpha1.Phase) bool {
+	switch phase {
+	case beeaiv1alpha1.PhaseSucceeded, beeaiv1alpha1.PhaseFailed, beeaiv1alpha1.PhaseCancelled:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dag resolves the implicit dependency graph between a Workflow's steps so that a
+// step's typed inputs can be wired from the outputs of the node it references.
+package dag
+
+import (
+	"fmt"
+	"regexp"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+// stepOutputRef matches references like "{{ .steps.retrieve.output }}" inside a
+// Step's Input.Template, which is how one step's typed output is wired into another's input.
+var stepOutputRef = regexp.MustCompile(`\.steps\.([a-zA-Z0-9_-]+)\.output`)
+
+// Graph is the resolved, dependency-ordered view of a Workflow's steps.
+type Graph struct {
+	// Order lists step names in an order where every step appears after the steps it depends on.
+	Order []string
+	// DependsOn maps a step name to the names of the steps whose outputs it consumes.
+	DependsOn map[string][]string
+}
+
+// Resolve builds the step dependency Graph for a Workflow template by scanning each step's
+// Input.Template for references to other steps' outputs. It returns an error if the steps form
+// a cycle or a step references a name that doesn't exist.
+func Resolve(steps []beeaiv1alpha1.Step) (*Graph, error) {
+	names := make(map[string]bool, len(steps))
+	for _, s := range steps {
+		names[s.Name] = true
+	}
+
+	dependsOn := make(map[string][]string, len(steps))
+	for _, s := range steps {
+		seen := make(map[string]bool)
+		for _, m := range stepOutputRef.FindAllStringSubmatch(s.Input.Template, -1) {
+			dep := m[1]
+			if !names[dep] {
+				return nil, fmt.Errorf("step %q references unknown step %q", s.Name, dep)
+			}
+			if dep != s.Name && !seen[dep] {
+				seen[dep] = true
+				dependsOn[s.Name] = append(dependsOn[s.Name], dep)
+			}
+		}
+	}
+
+	order, err := topoSort(steps, dependsOn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Graph{Order: order, DependsOn: dependsOn}, nil
+}
+
+func topoSort(steps []beeaiv1alpha1.Step, dependsOn map[string][]string) ([]string, error) {
+	const (
+		white = iota
+		gray
+		black
+	)
+	state := make(map[string]int, len(steps))
+	var order []string
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case black:
+			return nil
+		case gray:
+			return fmt.Errorf("cycle detected in workflow steps at %q", name)
+		}
+		state[name] = gray
+		for _, dep := range dependsOn[name] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[name] = black
+		order = append(order, name)
+		return nil
+	}
+
+	for _, s := range steps {
+		if err := visit(s.Name); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
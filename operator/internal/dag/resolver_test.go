@@ -0,0 +1,90 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dag
+
+import (
+	"testing"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+func step(name, template string) beeaiv1alpha1.Step {
+	return beeaiv1alpha1.Step{Name: name, Input: beeaiv1alpha1.Input{Template: template}}
+}
+
+func TestResolveOrdersStepsByDependency(t *testing.T) {
+	steps := []beeaiv1alpha1.Step{
+		step("summarize", "{{ .steps.retrieve.output.documents }}"),
+		step("retrieve", ""),
+		step("answer", "{{ .steps.summarize.output }} {{ .steps.retrieve.output.documents }}"),
+	}
+
+	graph, err := Resolve(steps)
+	if err != nil {
+		t.Fatalf("Resolve returned error: %v", err)
+	}
+
+	pos := make(map[string]int, len(graph.Order))
+	for i, name := range graph.Order {
+		pos[name] = i
+	}
+	if pos["retrieve"] > pos["summarize"] {
+		t.Errorf("expected retrieve before summarize, got order %v", graph.Order)
+	}
+	if pos["summarize"] > pos["answer"] {
+		t.Errorf("expected summarize before answer, got order %v", graph.Order)
+	}
+	if len(graph.DependsOn["answer"]) != 2 {
+		t.Errorf("expected answer to depend on 2 steps, got %v", graph.DependsOn["answer"])
+	}
+}
+
+func TestResolveUnknownStepReference(t *testing.T) {
+	steps := []beeaiv1alpha1.Step{
+		step("answer", "{{ .steps.missing.output }}"),
+	}
+
+	if _, err := Resolve(steps); err == nil {
+		t.Fatal("expected an error for a reference to an unknown step")
+	}
+}
+
+func TestResolveCycle(t *testing.T) {
+	steps := []beeaiv1alpha1.Step{
+		step("a", "{{ .steps.b.output }}"),
+		step("b", "{{ .steps.a.output }}"),
+	}
+
+	if _, err := Resolve(steps); err == nil {
+		t.Fatal("expected an error for a dependency cycle")
+	}
+}
+
+func TestResolveIndependentSteps(t *testing.T) {
+	steps := []beeaiv1alpha1.Step{
+		step("a", ""),
+		step("b", ""),
+	}
+
+	graph, err := Resolve(steps)
+	if err != nil {
+		t.Fatalf("Resolve returned error: %v", err)
+	}
+	if len(graph.Order) != 2 {
+		t.Fatalf("expected 2 steps in order, got %v", graph.Order)
+	}
+}
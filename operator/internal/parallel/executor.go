@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package parallel schedules the branches of a Step's ParallelSpec on a bounded worker pool
+// and aggregates their results according to the configured aggregation and failure policy.
+package parallel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+// BranchRunner executes a single branch Step and returns its output.
+type BranchRunner func(ctx context.Context, branch beeaiv1alpha1.Step) (string, error)
+
+// BranchResult is the outcome of running one branch.
+type BranchResult struct {
+	Status beeaiv1alpha1.StepStatus
+	Output string
+	Err    error
+}
+
+// Result is the aggregated outcome of running a ParallelSpec.
+type Result struct {
+	Branches []BranchResult
+	Output   string
+}
+
+// Run executes spec.Branches with up to spec.MaxConcurrency workers, honors FailurePolicy and
+// aggregates branch outputs per spec.Aggregation. On FailurePolicy "failFast" (the default) the
+// context passed to still-running branches is cancelled as soon as one branch fails.
+func Run(ctx context.Context, spec beeaiv1alpha1.ParallelSpec, run BranchRunner) (Result, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workers := spec.MaxConcurrency
+	if workers <= 0 || workers > len(spec.Branches) {
+		workers = len(spec.Branches)
+	}
+
+	branches := make(chan int)
+	results := make([]BranchResult, len(spec.Branches))
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range branches {
+				results[i] = runBranch(ctx, spec.Branches[i], run)
+				if results[i].Err != nil && spec.FailurePolicy != "continue" && spec.FailurePolicy != "threshold" {
+					cancel()
+				}
+			}
+		}()
+	}
+
+	for i := range spec.Branches {
+		branches <- i
+	}
+	close(branches)
+	wg.Wait()
+
+	return aggregate(spec, results)
+}
+
+func runBranch(ctx context.Context, branch beeaiv1alpha1.Step, run BranchRunner) BranchResult {
+	output, err := run(ctx, branch)
+	status := beeaiv1alpha1.StepStatus{Name: branch.Name, Phase: beeaiv1alpha1.PhaseSucceeded}
+	if err != nil {
+		status.Phase = beeaiv1alpha1.PhaseFailed
+		status.Message = err.Error()
+	}
+	return BranchResult{Status: status, Output: output, Err: err}
+}
+
+func aggregate(spec beeaiv1alpha1.ParallelSpec, results []BranchResult) (Result, error) {
+	succeeded := 0
+	for _, r := range results {
+		if r.Err == nil {
+			succeeded++
+		}
+	}
+
+	switch spec.FailurePolicy {
+	case "threshold":
+		if succeeded < spec.Threshold {
+			return Result{Branches: results}, fmt.Errorf("parallel step: only %d/%d branches succeeded, threshold is %d", succeeded, len(results), spec.Threshold)
+		}
+	case "continue":
+		// partial failures are tolerated
+	default: // failFast, or unset
+		for _, r := range results {
+			if r.Err != nil {
+				return Result{Branches: results}, fmt.Errorf("parallel step: branch %q failed: %w", r.Status.Name, r.Err)
+			}
+		}
+	}
+
+	var output string
+	switch spec.Aggregation {
+	case "first":
+		if len(results) > 0 {
+			output = results[0].Output
+		}
+	case "majority":
+		output = majority(results)
+	case "list":
+		outputs := make([]string, len(results))
+		for i, r := range results {
+			outputs[i] = r.Output
+		}
+		output = fmt.Sprintf("%v", outputs)
+	default: // merge
+		for _, r := range results {
+			output += r.Output
+		}
+	}
+
+	return Result{Branches: results, Output: output}, nil
+}
+
+func majority(results []BranchResult) string {
+	counts := make(map[string]int, len(results))
+	best := ""
+	bestCount := 0
+	for _, r := range results {
+		if r.Err != nil {
+			continue
+		}
+		counts[r.Output]++
+		if counts[r.Output] > bestCount {
+			best = r.Output
+			bestCount = counts[r.Output]
+		}
+	}
+	return best
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parallel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+func branches(names ...string) []beeaiv1alpha1.Step {
+	steps := make([]beeaiv1alpha1.Step, len(names))
+	for i, name := range names {
+		steps[i] = beeaiv1alpha1.Step{Name: name}
+	}
+	return steps
+}
+
+func TestRunMergeAggregation(t *testing.T) {
+	spec := beeaiv1alpha1.ParallelSpec{Branches: branches("a", "b")}
+
+	result, err := Run(context.Background(), spec, func(ctx context.Context, branch beeaiv1alpha1.Step) (string, error) {
+		return branch.Name, nil
+	})
+	if err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if result.Output != "ab" && result.Output != "ba" {
+		t.Errorf("expected merged output of both branches, got %q", result.Output)
+	}
+}
+
+func TestRunFirstAggregation(t *testing.T) {
+	spec := beeaiv1alpha1.ParallelSpec{Branches: branches("a", "b"), Aggregation: "first"}
+
+	result, err := Run(context.Background(), spec, func(ctx context.Context, branch beeaiv1alpha1.Step) (string, error) {
+		return branch.Name, nil
+	})
+	if err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if result.Output != spec.Branches[0].Name {
+		t.Errorf("expected output from first branch %q, got %q", spec.Branches[0].Name, result.Output)
+	}
+}
+
+func TestRunFailFastReturnsError(t *testing.T) {
+	spec := beeaiv1alpha1.ParallelSpec{Branches: branches("a", "b")}
+
+	_, err := Run(context.Background(), spec, func(ctx context.Context, branch beeaiv1alpha1.Step) (string, error) {
+		if branch.Name == "a" {
+			return "", errors.New("boom")
+		}
+		return branch.Name, nil
+	})
+	if err == nil {
+		t.Fatal("expected failFast (the default) to surface a branch error")
+	}
+}
+
+func TestRunContinueToleratesFailure(t *testing.T) {
+	spec := beeaiv1alpha1.ParallelSpec{Branches: branches("a", "b"), FailurePolicy: "continue"}
+
+	_, err := Run(context.Background(), spec, func(ctx context.Context, branch beeaiv1alpha1.Step) (string, error) {
+		if branch.Name == "a" {
+			return "", errors.New("boom")
+		}
+		return branch.Name, nil
+	})
+	if err != nil {
+		t.Fatalf("expected continue to tolerate a branch failure, got %v", err)
+	}
+}
+
+func TestRunThresholdRequiresMinimumSuccesses(t *testing.T) {
+	spec := beeaiv1alpha1.ParallelSpec{Branches: branches("a", "b", "c"), FailurePolicy: "threshold", Threshold: 3}
+
+	_, err := Run(context.Background(), spec, func(ctx context.Context, branch beeaiv1alpha1.Step) (string, error) {
+		if branch.Name == "a" {
+			return "", errors.New("boom")
+		}
+		return branch.Name, nil
+	})
+	if err == nil {
+		t.Fatal("expected threshold of 3 to fail when only 2 branches succeeded")
+	}
+}
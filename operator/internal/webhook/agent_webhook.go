@@ -0,0 +1,85 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+	"github.com/i-am-bee/beeai-labs/operator/pkg/agentruntime"
+)
+
+// AgentValidator validates an Agent against its Framework's declared capability set at
+// admission time, so a misconfigured Agent is rejected at `kubectl apply` rather than surfacing
+// as a runtime error later.
+type AgentValidator struct{}
+
+// +kubebuilder:webhook:path=/validate-beeai-dev-v1alpha1-agent,mutating=false,failurePolicy=fail,sideEffects=None,groups=beeai.dev,resources=agents,verbs=create;update,versions=v1alpha1,name=vagent.beeai.dev,admissionReviewVersions=v1
+
+var _ admission.CustomValidator = &AgentValidator{}
+
+func (v *AgentValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(obj)
+}
+
+func (v *AgentValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(newObj)
+}
+
+func (v *AgentValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *AgentValidator) validate(obj runtime.Object) error {
+	agent, ok := obj.(*beeaiv1alpha1.Agent)
+	if !ok {
+		return fmt.Errorf("expected an Agent but got %T", obj)
+	}
+
+	rt, err := agentruntime.Get(agent.Spec.Framework)
+	if err != nil {
+		return err
+	}
+
+	caps := rt.Capabilities()
+	spec := agent.Spec
+	switch {
+	case caps.RequiresModel && spec.Model == "":
+		return fmt.Errorf("agent %q: framework %q requires spec.model", agent.Name, spec.Framework)
+	case caps.RequiresCode && spec.Code == "":
+		return fmt.Errorf("agent %q: framework %q requires spec.code", agent.Name, spec.Framework)
+	case caps.RequiresUrl && spec.Url == "":
+		return fmt.Errorf("agent %q: framework %q requires spec.url", agent.Name, spec.Framework)
+	case !caps.SupportsTools && len(spec.Tools) > 0:
+		return fmt.Errorf("agent %q: framework %q does not support spec.tools", agent.Name, spec.Framework)
+	}
+
+	return rt.Validate(spec)
+}
+
+// SetupWebhookWithManager registers the validating webhook with the manager.
+func (v *AgentValidator) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&beeaiv1alpha1.Agent{}).
+		WithValidator(v).
+		Complete()
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+	"github.com/i-am-bee/beeai-labs/operator/internal/celeval"
+)
+
+// WorkflowValidator compiles every CEL expression (Condition.If, Condition.Case, Loop.Until)
+// and every Input.Template in a Workflow at admission time, so authors see a compile error at
+// `kubectl apply` instead of at run time.
+type WorkflowValidator struct{}
+
+// +kubebuilder:webhook:path=/validate-beeai-dev-v1alpha1-workflow,mutating=false,failurePolicy=fail,sideEffects=None,groups=beeai.dev,resources=workflows,verbs=create;update,versions=v1alpha1,name=vworkflow.beeai.dev,admissionReviewVersions=v1
+
+var _ admission.CustomValidator = &WorkflowValidator{}
+
+func (v *WorkflowValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(obj)
+}
+
+func (v *WorkflowValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(newObj)
+}
+
+func (v *WorkflowValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *WorkflowValidator) validate(obj runtime.Object) error {
+	workflow, ok := obj.(*beeaiv1alpha1.Workflow)
+	if !ok {
+		return fmt.Errorf("expected a Workflow but got %T", obj)
+	}
+
+	for _, step := range workflow.Spec.Template.Steps {
+		if err := validateStep(step); err != nil {
+			return fmt.Errorf("workflow %q: step %q: %w", workflow.Name, step.Name, err)
+		}
+	}
+	return nil
+}
+
+func validateStep(step beeaiv1alpha1.Step) error {
+	if step.Input.Template != "" {
+		if _, err := celeval.ParseTemplate(step.Input.Template); err != nil {
+			return fmt.Errorf("input.template: %w", err)
+		}
+	}
+
+	if step.Loop.Until != "" {
+		if _, err := celeval.Compile(step.Loop.Until); err != nil {
+			return fmt.Errorf("loop.until: %w", err)
+		}
+	}
+
+	for i, cond := range step.Condition {
+		if cond.If != "" {
+			if _, err := celeval.Compile(cond.If); err != nil {
+				return fmt.Errorf("condition[%d].if: %w", i, err)
+			}
+		}
+		if cond.Case != "" {
+			if _, err := celeval.Compile(cond.Case); err != nil {
+				return fmt.Errorf("condition[%d].case: %w", i, err)
+			}
+		}
+	}
+
+	for _, branch := range step.Parallel.Branches {
+		if err := validateStep(branch); err != nil {
+			return fmt.Errorf("parallel branch %q: %w", branch.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// SetupWebhookWithManager registers the validating webhook with the manager.
+func (v *WorkflowValidator) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&beeaiv1alpha1.Workflow{}).
+		WithValidator(v).
+		Complete()
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentruntime
+
+import (
+	"context"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+// beeaiImage is the default server image for the BeeAI Framework (Python/TS) adapter.
+const beeaiImage = "ghcr.io/i-am-bee/beeai-framework-server:latest"
+
+// beeaiRuntime invokes a BeeAI Framework server started from AgentSpec.Model/Tools/Instructions.
+type beeaiRuntime struct{}
+
+func (beeaiRuntime) Capabilities() Capabilities {
+	return Capabilities{RequiresModel: true, SupportsTools: true}
+}
+
+func (r beeaiRuntime) Validate(spec beeaiv1alpha1.AgentSpec) error {
+	return requireField(spec.Model != "", "model", "beeai")
+}
+
+func (r beeaiRuntime) Invoke(ctx context.Context, spec beeaiv1alpha1.AgentSpec, input Input) (Output, error) {
+	return httpInvoke(ctx, spec.Url, input)
+}
+
+func (r beeaiRuntime) HealthCheck(ctx context.Context, spec beeaiv1alpha1.AgentSpec) error {
+	return httpHealthCheck(ctx, spec.Url+"/health")
+}
+
+func (beeaiRuntime) ContainerImage(spec beeaiv1alpha1.AgentSpec) string {
+	return beeaiImage
+}
+
+func (beeaiRuntime) ContainerPort() int32 {
+	return 8000
+}
+
+func init() {
+	Register("beeai", beeaiRuntime{})
+}
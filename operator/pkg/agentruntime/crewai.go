@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentruntime
+
+import (
+	"context"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+// crewaiImage runs a CrewAI crew/agent server.
+const crewaiImage = "ghcr.io/i-am-bee/beeai-crewai-server:latest"
+
+// crewaiRuntime invokes a CrewAI agent server.
+type crewaiRuntime struct{}
+
+func (crewaiRuntime) Capabilities() Capabilities {
+	return Capabilities{RequiresModel: true, SupportsTools: true}
+}
+
+func (r crewaiRuntime) Validate(spec beeaiv1alpha1.AgentSpec) error {
+	return requireField(spec.Model != "", "model", "crewai")
+}
+
+func (r crewaiRuntime) Invoke(ctx context.Context, spec beeaiv1alpha1.AgentSpec, input Input) (Output, error) {
+	return httpInvoke(ctx, spec.Url, input)
+}
+
+func (r crewaiRuntime) HealthCheck(ctx context.Context, spec beeaiv1alpha1.AgentSpec) error {
+	return httpHealthCheck(ctx, spec.Url+"/health")
+}
+
+func (crewaiRuntime) ContainerImage(spec beeaiv1alpha1.AgentSpec) string {
+	return crewaiImage
+}
+
+func (crewaiRuntime) ContainerPort() int32 {
+	return 8002
+}
+
+func init() {
+	Register("crewai", crewaiRuntime{})
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentruntime
+
+import (
+	"context"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+// httpRuntime calls a plain, already-running OpenAI-compatible endpoint at AgentSpec.Url. It
+// renders no Deployment/Service of its own since the endpoint is expected to be external.
+type httpRuntime struct{}
+
+func (httpRuntime) Capabilities() Capabilities {
+	return Capabilities{RequiresUrl: true, SupportsTools: false}
+}
+
+func (r httpRuntime) Validate(spec beeaiv1alpha1.AgentSpec) error {
+	return requireField(spec.Url != "", "url", "http")
+}
+
+func (r httpRuntime) Invoke(ctx context.Context, spec beeaiv1alpha1.AgentSpec, input Input) (Output, error) {
+	return httpInvoke(ctx, spec.Url, input)
+}
+
+func (r httpRuntime) HealthCheck(ctx context.Context, spec beeaiv1alpha1.AgentSpec) error {
+	return httpHealthCheck(ctx, spec.Url)
+}
+
+func (httpRuntime) ContainerImage(spec beeaiv1alpha1.AgentSpec) string {
+	// The endpoint is external; the controller does not render a Deployment for this framework.
+	return ""
+}
+
+func (httpRuntime) ContainerPort() int32 {
+	return 0
+}
+
+func init() {
+	Register("http", httpRuntime{})
+}
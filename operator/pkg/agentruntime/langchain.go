@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentruntime
+
+import (
+	"context"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+// langchainImage is a sidecar that executes AgentSpec.Code against a LangChain runtime.
+const langchainImage = "ghcr.io/i-am-bee/beeai-langchain-sidecar:latest"
+
+// langchainRuntime runs AgentSpec.Code as a LangChain agent/chain inside a sidecar container.
+type langchainRuntime struct{}
+
+func (langchainRuntime) Capabilities() Capabilities {
+	return Capabilities{RequiresCode: true, SupportsTools: true}
+}
+
+func (r langchainRuntime) Validate(spec beeaiv1alpha1.AgentSpec) error {
+	return requireField(spec.Code != "", "code", "langchain")
+}
+
+func (r langchainRuntime) Invoke(ctx context.Context, spec beeaiv1alpha1.AgentSpec, input Input) (Output, error) {
+	return httpInvoke(ctx, spec.Url, input)
+}
+
+func (r langchainRuntime) HealthCheck(ctx context.Context, spec beeaiv1alpha1.AgentSpec) error {
+	return httpHealthCheck(ctx, spec.Url+"/health")
+}
+
+func (langchainRuntime) ContainerImage(spec beeaiv1alpha1.AgentSpec) string {
+	return langchainImage
+}
+
+func (langchainRuntime) ContainerPort() int32 {
+	return 8001
+}
+
+func init() {
+	Register("langchain", langchainRuntime{})
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package agentruntime dispatches Agent invocations to one of several pluggable framework
+// backends (BeeAI, LangChain, CrewAI, a raw OpenAI-compatible HTTP endpoint, ...) so a Workflow
+// can mix frameworks without the operator hard-coding any one of them.
+package agentruntime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+// Input is what a Step passes into an Agent invocation.
+type Input struct {
+	Prompt string
+}
+
+// Output is what an Agent invocation returns.
+type Output struct {
+	Text string
+}
+
+// Capabilities declares what a runtime supports, so the admission webhook can validate an
+// AgentSpec against its target framework before it is ever scheduled.
+type Capabilities struct {
+	// RequiresModel is true if AgentSpec.Model must be set.
+	RequiresModel bool
+	// RequiresCode is true if AgentSpec.Code must be set (e.g. the langchain sidecar).
+	RequiresCode bool
+	// RequiresUrl is true if AgentSpec.Url must be set (e.g. the http adapter).
+	RequiresUrl bool
+	// SupportsTools is false if the runtime cannot be given AgentSpec.Tools.
+	SupportsTools bool
+}
+
+// Runtime is implemented by each framework adapter.
+type Runtime interface {
+	// Invoke calls the agent described by spec with input and returns its output.
+	Invoke(ctx context.Context, spec beeaiv1alpha1.AgentSpec, input Input) (Output, error)
+	// Validate checks spec against the runtime's capabilities, independent of any live call.
+	Validate(spec beeaiv1alpha1.AgentSpec) error
+	// HealthCheck probes the agent's live endpoint.
+	HealthCheck(ctx context.Context, spec beeaiv1alpha1.AgentSpec) error
+	// Capabilities describes what this runtime supports.
+	Capabilities() Capabilities
+	// ContainerImage is the image the Agent controller runs as the agent's Deployment.
+	ContainerImage(spec beeaiv1alpha1.AgentSpec) string
+	// ContainerPort is the port the image serves on.
+	ContainerPort() int32
+}
+
+var registry = map[string]Runtime{}
+
+// Register adds a Runtime under the given framework name. Adapters call this from their
+// package init().
+func Register(framework string, r Runtime) {
+	registry[framework] = r
+}
+
+// Get looks up the Runtime registered for an AgentSpec.Framework value.
+func Get(framework string) (Runtime, error) {
+	r, ok := registry[framework]
+	if !ok {
+		return nil, fmt.Errorf("unknown agent framework %q", framework)
+	}
+	return r, nil
+}
+
+// Frameworks lists every registered framework name.
+func Frameworks() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	return names
+}
+
+func requireField(ok bool, field, framework string) error {
+	if !ok {
+		return fmt.Errorf("%s is required for framework %q", field, framework)
+	}
+	return nil
+}
+
+// EnvFor builds the environment the Agent controller passes to the Deployment container it
+// renders for a spec's framework, so the running server knows its model, instructions and tools.
+func EnvFor(spec beeaiv1alpha1.AgentSpec) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{Name: "AGENT_MODEL", Value: spec.Model},
+		{Name: "AGENT_INSTRUCTIONS", Value: spec.Instructions},
+		{Name: "AGENT_TOOLS", Value: strings.Join(spec.Tools, ",")},
+	}
+}
+
+// ResolvedSpec returns a copy of spec with Url set to the agent's resolved, reachable endpoint
+// (e.g. AgentStatus.URL) whenever one is known, so Invoke/HealthCheck never fall back to a
+// spec.Url the controller has already decided to ignore in favor of an in-cluster Service.
+func ResolvedSpec(spec beeaiv1alpha1.AgentSpec, resolvedURL string) beeaiv1alpha1.AgentSpec {
+	if resolvedURL != "" {
+		spec.Url = resolvedURL
+	}
+	return spec
+}
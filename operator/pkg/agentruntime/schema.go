@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentruntime
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/xeipuuv/gojsonschema"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+// ValidateInput checks a step's rendered prompt against spec.Input's JSON Schema. An unset schema
+// (the common case today) is not validated.
+func ValidateInput(spec beeaiv1alpha1.AgentSpec, prompt string) error {
+	return validateAgainstSchema(spec.Input, prompt, "input")
+}
+
+// ValidateOutput checks an agent's returned output text against spec.Output's JSON Schema. An
+// unset schema is not validated.
+func ValidateOutput(spec beeaiv1alpha1.AgentSpec, output string) error {
+	return validateAgainstSchema(spec.Output, output, "output")
+}
+
+// validateAgainstSchema validates data (expected to be JSON text) against schema. Data that isn't
+// valid JSON is only rejected once a schema has actually been set, so plain-text prompts and
+// outputs are unaffected by agents that don't declare one.
+func validateAgainstSchema(schema apiextensionsv1.JSON, data, field string) error {
+	if len(schema.Raw) == 0 {
+		return nil
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(data), &parsed); err != nil {
+		return fmt.Errorf("%s does not match its schema: not valid JSON: %w", field, err)
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(schema.Raw), gojsonschema.NewGoLoader(parsed))
+	if err != nil {
+		return fmt.Errorf("compiling %s schema: %w", field, err)
+	}
+	if !result.Valid() {
+		return fmt.Errorf("%s does not match its schema: %v", field, result.Errors())
+	}
+	return nil
+}
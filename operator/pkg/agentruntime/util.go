@@ -0,0 +1,84 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentruntime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// promptBody encodes a prompt as the minimal OpenAI-compatible request body every adapter in
+// this package sends.
+func promptBody(prompt string) *bytes.Reader {
+	body, _ := json.Marshal(map[string]string{"prompt": prompt})
+	return bytes.NewReader(body)
+}
+
+func readBody(resp *http.Response) string {
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// httpHealthCheck is shared by every adapter (beeai, langchain, crewai, http): it issues a
+// GET to url and treats any 2xx response as healthy.
+func httpHealthCheck(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("health check request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("health check returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// InvokeURL posts input directly to url and returns the response as output. It is exported for
+// node kinds, such as Tool, that are invoked by a fixed endpoint rather than dispatched through
+// the Agent/Runtime framework registry.
+func InvokeURL(ctx context.Context, url string, input Input) (Output, error) {
+	return httpInvoke(ctx, url, input)
+}
+
+// httpInvoke posts a plain-text prompt to url and returns the response body as the output text.
+func httpInvoke(ctx context.Context, url string, input Input) (Output, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, promptBody(input.Prompt))
+	if err != nil {
+		return Output{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Output{}, fmt.Errorf("invoke request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Output{}, fmt.Errorf("invoke returned status %d", resp.StatusCode)
+	}
+	return Output{Text: readBody(resp)}, nil
+}
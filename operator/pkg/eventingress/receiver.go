@@ -0,0 +1,200 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eventingress is an HTTP receiver that turns incoming CloudEvents 1.0 notifications
+// (binary or structured mode) into WorkflowRuns.
+package eventingress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/google/cel-go/cel"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	beeaiv1alpha1 "github.com/i-am-bee/beeai-labs/operator/api/v1alpha1"
+)
+
+// Receiver is an http.Handler that accepts CloudEvents on two paths: "/events/{workflow}"
+// validates the named Workflow exists and instantiates a single WorkflowRun for it, binding the
+// event payload into WorkflowRunSpec.Input; plain "/events" instead matches the event against
+// every EventBinding in Namespace to support type+filter routing. The two are mutually
+// exclusive so one CloudEvent never produces more than one WorkflowRun.
+type Receiver struct {
+	Client    client.Client
+	Scheme    *runtime.Scheme
+	Namespace string
+}
+
+func NewReceiver(c client.Client, scheme *runtime.Scheme, namespace string) *Receiver {
+	return &Receiver{Client: c, Scheme: scheme, Namespace: namespace}
+}
+
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	msg := cehttp.NewMessageFromHttpRequest(req)
+	defer msg.Finish(nil)
+
+	event, err := binding.ToEvent(req.Context(), msg)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid cloudevent: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	// A path of exactly "/events" (no trailing workflow name) fans the event out purely through
+	// EventBinding matching. A path of "/events/{workflow}" targets that Workflow directly and
+	// bypasses EventBinding matching, so a single CloudEvent never creates two runs.
+	workflow := strings.TrimPrefix(req.URL.Path, "/events")
+	workflow = strings.TrimPrefix(workflow, "/")
+
+	if workflow == "" {
+		if err := r.dispatchBindings(req.Context(), event); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	if err := r.Client.Get(req.Context(), client.ObjectKey{Namespace: r.Namespace, Name: workflow}, &beeaiv1alpha1.Workflow{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, fmt.Sprintf("workflow %q not found", workflow), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := r.createRun(req.Context(), workflow, event.Type(), event.ID(), event.Source(), event.Data()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// dispatchBindings fans the event out to every EventBinding whose EventType matches and whose
+// CEL Filter (if any) evaluates to true, creating a WorkflowRun for each match.
+func (r *Receiver) dispatchBindings(ctx context.Context, event cloudEvent) error {
+	var bindings beeaiv1alpha1.EventBindingList
+	if err := r.Client.List(ctx, &bindings, client.InNamespace(r.Namespace)); err != nil {
+		return err
+	}
+
+	var data interface{}
+	_ = json.Unmarshal(event.Data(), &data)
+
+	for _, b := range bindings.Items {
+		if b.Spec.EventType != event.Type() {
+			continue
+		}
+		ok, err := evalFilter(b.Spec.Filter, event.Type(), event.Source(), data)
+		if err != nil {
+			return fmt.Errorf("eventbinding %q: %w", b.Name, err)
+		}
+		if !ok {
+			continue
+		}
+		if err := r.createRun(ctx, b.Spec.Workflow, event.Type(), event.ID(), event.Source(), event.Data()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Receiver) createRun(ctx context.Context, workflow, ceType, ceID, ceSource string, data []byte) error {
+	run := &beeaiv1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: workflow + "-",
+			Namespace:    r.Namespace,
+			Annotations: map[string]string{
+				"beeai.dev/trigger-source": ceSource,
+				"beeai.dev/trigger-id":     ceID,
+				"beeai.dev/trigger-type":   ceType,
+				"beeai.dev/trigger-time":   time.Now().Format(time.RFC3339),
+			},
+		},
+		Spec: beeaiv1alpha1.WorkflowRunSpec{
+			Workflow: workflow,
+			Input:    beeaiv1alpha1.Input{Prompt: string(data)},
+		},
+	}
+	return r.Client.Create(ctx, run)
+}
+
+// evalFilter evaluates a CEL expression against the CloudEvent's type, source and data. An
+// empty filter always matches.
+func evalFilter(filter, ceType, ceSource string, data interface{}) (bool, error) {
+	if filter == "" {
+		return true, nil
+	}
+
+	env, err := cel.NewEnv(
+		cel.Variable("type", cel.StringType),
+		cel.Variable("source", cel.StringType),
+		cel.Variable("data", cel.DynType),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	ast, iss := env.Compile(filter)
+	if iss.Err() != nil {
+		return false, iss.Err()
+	}
+
+	prg, err := env.Program(ast)
+	if err != nil {
+		return false, err
+	}
+
+	out, _, err := prg.Eval(map[string]interface{}{
+		"type":   ceType,
+		"source": ceSource,
+		"data":   data,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	matched, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("filter %q did not evaluate to a bool", filter)
+	}
+	return matched, nil
+}
+
+// cloudEvent is the subset of event.Event used here, kept narrow so this file only depends on
+// the CloudEvents SDK through the binding/http packages actually needed for decoding.
+type cloudEvent interface {
+	Type() string
+	ID() string
+	Source() string
+	Data() []byte
+}